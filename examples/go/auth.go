@@ -0,0 +1,142 @@
+package userservice
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type contextKey int
+
+const isAdminContextKey contextKey = iota
+
+// ContextWithAdmin returns a copy of ctx marked as belonging to an admin
+// caller (or not). Wails bindings should set this from the authenticated
+// session before calling PromoteUser/DemoteUser.
+func ContextWithAdmin(ctx context.Context, isAdmin bool) context.Context {
+	return context.WithValue(ctx, isAdminContextKey, isAdmin)
+}
+
+// IsAdmin reports whether ctx was marked as an admin caller via
+// ContextWithAdmin.
+func IsAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(isAdminContextKey).(bool)
+	return admin
+}
+
+// RegisterUser creates a new user with a bcrypt-hashed password. It returns
+// the same validation errors as CreateUser, plus ErrPasswordTooShort if
+// password is shorter than the configured minimum.
+func (s *UserService) RegisterUser(ctx context.Context, name, email, password string) (User, error) {
+	if len(password) < s.auth.minPasswordLength {
+		return User{}, ErrPasswordTooShort
+	}
+	if err := validateName(name); err != nil {
+		return User{}, err
+	}
+	if err := validateEmail(email); err != nil {
+		return User{}, err
+	}
+	if err := s.checkEmailAvailable(ctx, email, 0); err != nil {
+		return User{}, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.auth.bcryptCost)
+	if err != nil {
+		return User{}, err
+	}
+
+	user, err := s.repo.Create(ctx, User{Name: name, Email: email, passwordHash: hash})
+	if err != nil {
+		return User{}, err
+	}
+	s.events.emit(UserEvent{Kind: EventCreated, User: user, At: time.Now()})
+	return user, nil
+}
+
+// Authenticate returns the user matching email if password is correct, or
+// ErrInvalidCredentials otherwise.
+func (s *UserService) Authenticate(ctx context.Context, email, password string) (User, error) {
+	user, err := s.repo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == ErrUserNotFound {
+			// Still run a bcrypt compare, against a fixed dummy hash, so this
+			// path costs the same as a wrong-password one and callers can't
+			// learn which emails are registered by timing Authenticate.
+			bcrypt.CompareHashAndPassword(s.auth.dummyHash, []byte(password))
+			return User{}, ErrInvalidCredentials
+		}
+		return User{}, err
+	}
+	if bcrypt.CompareHashAndPassword(user.passwordHash, []byte(password)) != nil {
+		return User{}, ErrInvalidCredentials
+	}
+	return user, nil
+}
+
+// ChangePassword verifies old against the stored hash and, if it matches,
+// replaces it with a hash of new.
+func (s *UserService) ChangePassword(ctx context.Context, id int, old, new string) error {
+	if len(new) < s.auth.minPasswordLength {
+		return ErrPasswordTooShort
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword(user.passwordHash, []byte(old)) != nil {
+		return ErrInvalidCredentials
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(new), s.auth.bcryptCost)
+	if err != nil {
+		return err
+	}
+	user.passwordHash = hash
+	updated, err := s.repo.Update(ctx, user)
+	if err != nil {
+		return err
+	}
+	s.events.emit(UserEvent{Kind: EventUpdated, User: updated, At: time.Now()})
+	return nil
+}
+
+// PromoteUser grants admin privileges to the user with the given ID. The
+// caller must be an admin itself, per IsAdmin(ctx).
+func (s *UserService) PromoteUser(ctx context.Context, id int) (User, error) {
+	if !IsAdmin(ctx) {
+		return User{}, ErrForbidden
+	}
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+	user.Admin = true
+	updated, err := s.repo.Update(ctx, user)
+	if err != nil {
+		return User{}, err
+	}
+	s.events.emit(UserEvent{Kind: EventUpdated, User: updated, At: time.Now()})
+	return updated, nil
+}
+
+// DemoteUser revokes admin privileges from the user with the given ID. The
+// caller must be an admin itself, per IsAdmin(ctx).
+func (s *UserService) DemoteUser(ctx context.Context, id int) (User, error) {
+	if !IsAdmin(ctx) {
+		return User{}, ErrForbidden
+	}
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+	user.Admin = false
+	updated, err := s.repo.Update(ctx, user)
+	if err != nil {
+		return User{}, err
+	}
+	s.events.emit(UserEvent{Kind: EventUpdated, User: updated, At: time.Now()})
+	return updated, nil
+}
@@ -0,0 +1,128 @@
+package userservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegisterAndAuthenticate(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	user, err := s.RegisterUser(ctx, "Ada", "ada@example.com", "correct horse")
+	if err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+
+	authed, err := s.Authenticate(ctx, "ada@example.com", "correct horse")
+	if err != nil {
+		t.Fatalf("Authenticate with correct password: %v", err)
+	}
+	if authed.ID != user.ID {
+		t.Fatalf("Authenticate returned user %d, want %d", authed.ID, user.ID)
+	}
+}
+
+func TestAuthenticateWrongPassword(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	if _, err := s.RegisterUser(ctx, "Ada", "ada@example.com", "correct horse"); err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+
+	if _, err := s.Authenticate(ctx, "ada@example.com", "wrong password"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate with wrong password error = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthenticateUnknownEmail(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+
+	if _, err := s.Authenticate(context.Background(), "nobody@example.com", "whatever"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate with unknown email error = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestRegisterUserPasswordTooShort(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+
+	if _, err := s.RegisterUser(context.Background(), "Ada", "ada@example.com", "short"); !errors.Is(err, ErrPasswordTooShort) {
+		t.Fatalf("RegisterUser with short password error = %v, want ErrPasswordTooShort", err)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	user, err := s.RegisterUser(ctx, "Ada", "ada@example.com", "correct horse")
+	if err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+
+	if err := s.ChangePassword(ctx, user.ID, "wrong old password", "new password"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("ChangePassword with wrong old password error = %v, want ErrInvalidCredentials", err)
+	}
+
+	if err := s.ChangePassword(ctx, user.ID, "correct horse", "new password"); err != nil {
+		t.Fatalf("ChangePassword: %v", err)
+	}
+
+	if _, err := s.Authenticate(ctx, "ada@example.com", "correct horse"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("Authenticate with old password after change = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := s.Authenticate(ctx, "ada@example.com", "new password"); err != nil {
+		t.Fatalf("Authenticate with new password: %v", err)
+	}
+}
+
+func TestChangePasswordTooShort(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	user, err := s.RegisterUser(ctx, "Ada", "ada@example.com", "correct horse")
+	if err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+
+	if err := s.ChangePassword(ctx, user.ID, "correct horse", "short"); !errors.Is(err, ErrPasswordTooShort) {
+		t.Fatalf("ChangePassword with short new password error = %v, want ErrPasswordTooShort", err)
+	}
+}
+
+func TestPromoteDemoteUserRequiresAdmin(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	user, err := s.CreateUser(ctx, "Ada", "ada@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	if _, err := s.PromoteUser(ctx, user.ID); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("PromoteUser without admin context error = %v, want ErrForbidden", err)
+	}
+
+	adminCtx := ContextWithAdmin(ctx, true)
+	promoted, err := s.PromoteUser(adminCtx, user.ID)
+	if err != nil {
+		t.Fatalf("PromoteUser with admin context: %v", err)
+	}
+	if !promoted.Admin {
+		t.Fatal("PromoteUser did not set Admin = true")
+	}
+
+	if _, err := s.DemoteUser(ctx, user.ID); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("DemoteUser without admin context error = %v, want ErrForbidden", err)
+	}
+
+	demoted, err := s.DemoteUser(adminCtx, user.ID)
+	if err != nil {
+		t.Fatalf("DemoteUser with admin context: %v", err)
+	}
+	if demoted.Admin {
+		t.Fatal("DemoteUser did not set Admin = false")
+	}
+}
@@ -0,0 +1,65 @@
+package userservice
+
+import "sync"
+
+// subscriberBufferSize bounds how many events a subscriber can lag behind
+// before emit starts dropping its oldest unread event.
+const subscriberBufferSize = 16
+
+// broker fans a stream of UserEvents out to any number of subscribers. A
+// slow subscriber never blocks emit: once its buffer is full, its oldest
+// queued event is dropped to make room for the new one.
+type broker struct {
+	mu   sync.RWMutex
+	subs map[chan UserEvent]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{subs: make(map[chan UserEvent]struct{})}
+}
+
+// subscribe registers a new subscriber and returns its channel plus an
+// unsubscribe function. unsubscribe is safe to call more than once and is
+// safe to call concurrently with emit.
+func (b *broker) subscribe() (chan UserEvent, func()) {
+	ch := make(chan UserEvent, subscriberBufferSize)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if _, ok := b.subs[ch]; ok {
+				delete(b.subs, ch)
+				close(ch)
+			}
+		})
+	}
+	return ch, unsubscribe
+}
+
+// emit delivers event to every current subscriber without blocking. If a
+// subscriber's buffer is full, its oldest event is dropped to make room.
+func (b *broker) emit(event UserEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- event:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package userservice
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBrokerConcurrentSubscribers(t *testing.T) {
+	b := newBroker()
+
+	const subscribers = 10
+	var wg sync.WaitGroup
+	wg.Add(subscribers)
+	for i := 0; i < subscribers; i++ {
+		ch, unsubscribe := b.subscribe()
+		go func() {
+			defer wg.Done()
+			defer unsubscribe()
+			select {
+			case event := <-ch:
+				if event.Kind != EventCreated {
+					t.Errorf("got kind %v, want EventCreated", event.Kind)
+				}
+			case <-time.After(time.Second):
+				t.Error("timed out waiting for event")
+			}
+		}()
+	}
+
+	b.emit(UserEvent{Kind: EventCreated, User: User{ID: 1}})
+	wg.Wait()
+}
+
+func TestBrokerUnsubscribeDuringEmit(t *testing.T) {
+	b := newBroker()
+
+	ch, unsubscribe := b.subscribe()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			b.emit(UserEvent{Kind: EventUpdated, User: User{ID: i}})
+		}
+	}()
+
+	unsubscribe()
+	unsubscribe() // must be safe to call twice
+	wg.Wait()
+
+	// Events buffered before unsubscribe are still delivered; after at most
+	// subscriberBufferSize of them the channel must report closed.
+	for i := 0; ; i++ {
+		if _, ok := <-ch; !ok {
+			break
+		}
+		if i >= subscriberBufferSize {
+			t.Fatal("expected channel to be closed after unsubscribe")
+		}
+	}
+}
+
+func TestBrokerSlowConsumerBackpressure(t *testing.T) {
+	b := newBroker()
+
+	ch, unsubscribe := b.subscribe()
+	defer unsubscribe()
+
+	total := subscriberBufferSize * 4
+	for i := 0; i < total; i++ {
+		b.emit(UserEvent{Kind: EventDeleted, User: User{ID: i}})
+	}
+
+	if got := len(ch); got != subscriberBufferSize {
+		t.Fatalf("buffered events = %d, want %d", got, subscriberBufferSize)
+	}
+
+	first := <-ch
+	if first.User.ID != total-subscriberBufferSize {
+		t.Fatalf("oldest buffered event has ID %d, want %d (old events should be dropped)", first.User.ID, total-subscriberBufferSize)
+	}
+}
@@ -0,0 +1,96 @@
+package userservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestConcurrentCreateUserDuplicateEmail fires the same email at CreateUser
+// from many goroutines at once. Exactly one must win; the rest must see
+// ErrDuplicateEmail rather than two users sharing an email.
+func TestConcurrentCreateUserDuplicateEmail(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+
+	const attempts = 50
+	var succeeded int64
+	var wg sync.WaitGroup
+	wg.Add(attempts)
+	for i := 0; i < attempts; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := s.CreateUser(context.Background(), "Ada", "ada@example.com")
+			switch {
+			case err == nil:
+				atomic.AddInt64(&succeeded, 1)
+			case errors.Is(err, ErrDuplicateEmail):
+				// expected for every loser
+			default:
+				t.Errorf("CreateUser returned unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Fatalf("succeeded = %d, want exactly 1", succeeded)
+	}
+
+	page, err := s.ListUsers(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	if page.Total != 1 {
+		t.Fatalf("Total users = %d, want 1", page.Total)
+	}
+}
+
+// TestConcurrentCreateAndDeleteUser exercises CreateUser and DeleteUser from
+// many goroutines at once; run with -race to catch data races on the
+// underlying slice/maps.
+func TestConcurrentCreateAndDeleteUser(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	const users = 50
+	ids := make(chan int, users)
+
+	var createWg sync.WaitGroup
+	createWg.Add(users)
+	for i := 0; i < users; i++ {
+		go func(i int) {
+			defer createWg.Done()
+			u, err := s.CreateUser(ctx, fmt.Sprintf("User %d", i), fmt.Sprintf("user%d@example.com", i))
+			if err != nil {
+				t.Errorf("CreateUser(%d): %v", i, err)
+				return
+			}
+			ids <- u.ID
+		}(i)
+	}
+	createWg.Wait()
+	close(ids)
+
+	var deleteWg sync.WaitGroup
+	for id := range ids {
+		deleteWg.Add(1)
+		go func(id int) {
+			defer deleteWg.Done()
+			if err := s.DeleteUser(ctx, id); err != nil {
+				t.Errorf("DeleteUser(%d): %v", id, err)
+			}
+		}(id)
+	}
+	deleteWg.Wait()
+
+	page, err := s.ListUsers(ctx, ListOptions{})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	if page.Total != 0 {
+		t.Fatalf("Total users after deleting all = %d, want 0", page.Total)
+	}
+}
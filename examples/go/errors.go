@@ -0,0 +1,33 @@
+package userservice
+
+import "errors"
+
+// Sentinel errors returned by UserService and its repositories. Callers
+// (including Wails bindings) should use errors.Is to check for these rather
+// than matching on error strings.
+var (
+	// ErrUserNotFound is returned when no user matches the given ID or email.
+	ErrUserNotFound = errors.New("userservice: user not found")
+
+	// ErrInvalidEmail is returned when an email fails net/mail.ParseAddress.
+	ErrInvalidEmail = errors.New("userservice: invalid email")
+
+	// ErrEmptyName is returned when a user's name is empty or blank.
+	ErrEmptyName = errors.New("userservice: name must not be empty")
+
+	// ErrDuplicateEmail is returned when another user already owns the
+	// given email address.
+	ErrDuplicateEmail = errors.New("userservice: email already in use")
+
+	// ErrInvalidCredentials is returned by Authenticate and ChangePassword
+	// when the email/password combination doesn't match.
+	ErrInvalidCredentials = errors.New("userservice: invalid credentials")
+
+	// ErrForbidden is returned when a caller without admin privileges calls
+	// PromoteUser or DemoteUser.
+	ErrForbidden = errors.New("userservice: admin privileges required")
+
+	// ErrPasswordTooShort is returned when a password is shorter than the
+	// configured minimum length.
+	ErrPasswordTooShort = errors.New("userservice: password too short")
+)
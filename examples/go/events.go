@@ -0,0 +1,75 @@
+package userservice
+
+import (
+	"context"
+	"time"
+)
+
+// EventKind identifies the kind of mutation a UserEvent represents.
+type EventKind int
+
+const (
+	EventCreated EventKind = iota
+	EventUpdated
+	EventDeleted
+)
+
+// UserEvent is emitted by UserService whenever CreateUser, UpdateUser or
+// DeleteUser succeeds.
+type UserEvent struct {
+	Kind EventKind
+	User User
+	At   time.Time
+}
+
+// Subscribe returns a channel of UserEvents and an unsubscribe function. The
+// channel is closed once unsubscribe is called, or automatically when ctx is
+// done. Subscribe is safe to call concurrently with CreateUser, UpdateUser
+// and DeleteUser.
+func (s *UserService) Subscribe(ctx context.Context) (<-chan UserEvent, func()) {
+	ch, unsubscribe := s.events.subscribe()
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+	return ch, unsubscribe
+}
+
+// WailsRuntime is the subset of Wails' runtime package EmitToWails needs. Its
+// method has the signature of runtime.EventsEmit, so a Wails app satisfies it
+// with a small type whose EventsEmit method calls runtime.EventsEmit.
+type WailsRuntime interface {
+	EventsEmit(ctx context.Context, eventName string, optionalData ...any)
+}
+
+// eventTopic returns the Wails event name the frontend should listen for via
+// runtime.EventsOn.
+func eventTopic(kind EventKind) string {
+	switch kind {
+	case EventCreated:
+		return "user:created"
+	case EventUpdated:
+		return "user:updated"
+	case EventDeleted:
+		return "user:deleted"
+	default:
+		return "user:unknown"
+	}
+}
+
+// EmitToWails forwards events to rt.EventsEmit under "user:created",
+// "user:updated" and "user:deleted" until ctx is done or events is closed.
+// Call it in a goroutine with the channel returned by Subscribe.
+func EmitToWails(ctx context.Context, rt WailsRuntime, events <-chan UserEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			rt.EventsEmit(ctx, eventTopic(event.Kind), event)
+		}
+	}
+}
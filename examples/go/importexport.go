@@ -0,0 +1,192 @@
+package userservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ImportMode controls how ImportUsers reconciles incoming rows against
+// existing users.
+type ImportMode int
+
+const (
+	// ImportReplace deletes every existing user before importing.
+	ImportReplace ImportMode = iota
+	// ImportMerge creates new users and updates existing ones matched by email.
+	ImportMerge
+	// ImportSkipConflicts creates new users but leaves existing ones (matched
+	// by email) untouched.
+	ImportSkipConflicts
+)
+
+// RowError records a single input row that failed to import.
+type RowError struct {
+	Row   int
+	Error string
+}
+
+// ImportReport summarizes the result of an ImportUsers call.
+type ImportReport struct {
+	Created int
+	Updated int
+	Skipped int
+	Errors  []RowError
+}
+
+// ExportUsers serializes every user as "json" or "csv".
+func (s *UserService) ExportUsers(ctx context.Context, format string) ([]byte, error) {
+	users, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "json":
+		return json.Marshal(users)
+	case "csv":
+		return marshalUsersCSV(users)
+	default:
+		return nil, fmt.Errorf("userservice: unsupported export format %q", format)
+	}
+}
+
+// ImportUsers parses data ("json" or "csv", each row a name/email pair) and
+// applies the rows to the store according to mode. Rows that fail validation
+// are skipped and recorded in the returned report rather than aborting the
+// whole import.
+func (s *UserService) ImportUsers(ctx context.Context, format string, data []byte, mode ImportMode) (ImportReport, error) {
+	rows, err := parseUserRows(format, data)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	if mode == ImportReplace {
+		existing, err := s.repo.GetAll(ctx)
+		if err != nil {
+			return ImportReport{}, err
+		}
+		for _, user := range existing {
+			if err := s.repo.Delete(ctx, user.ID); err != nil {
+				return ImportReport{}, err
+			}
+			s.events.emit(UserEvent{Kind: EventDeleted, User: user, At: time.Now()})
+		}
+	}
+
+	var report ImportReport
+	for i, row := range rows {
+		if err := validateName(row.Name); err != nil {
+			report.Skipped++
+			report.Errors = append(report.Errors, RowError{Row: i, Error: err.Error()})
+			continue
+		}
+		if err := validateEmail(row.Email); err != nil {
+			report.Skipped++
+			report.Errors = append(report.Errors, RowError{Row: i, Error: err.Error()})
+			continue
+		}
+
+		existing, err := s.repo.FindByEmail(ctx, row.Email)
+		switch {
+		case err == ErrUserNotFound:
+			created, err := s.repo.Create(ctx, User{Name: row.Name, Email: row.Email})
+			if err != nil {
+				report.Errors = append(report.Errors, RowError{Row: i, Error: err.Error()})
+				continue
+			}
+			s.events.emit(UserEvent{Kind: EventCreated, User: created, At: time.Now()})
+			report.Created++
+		case err != nil:
+			report.Errors = append(report.Errors, RowError{Row: i, Error: err.Error()})
+		case mode == ImportSkipConflicts:
+			report.Skipped++
+		default: // ImportMerge, or ImportReplace re-creating a row that raced with another importer
+			existing.Name = row.Name
+			existing.Email = row.Email
+			updated, err := s.repo.Update(ctx, existing)
+			if err != nil {
+				report.Errors = append(report.Errors, RowError{Row: i, Error: err.Error()})
+				continue
+			}
+			s.events.emit(UserEvent{Kind: EventUpdated, User: updated, At: time.Now()})
+			report.Updated++
+		}
+	}
+
+	return report, nil
+}
+
+// userRow is the intermediate shape ImportUsers works with once a "json" or
+// "csv" payload has been parsed.
+type userRow struct {
+	Name  string
+	Email string
+}
+
+func parseUserRows(format string, data []byte) ([]userRow, error) {
+	switch format {
+	case "json":
+		var users []User
+		if err := json.Unmarshal(data, &users); err != nil {
+			return nil, err
+		}
+		rows := make([]userRow, len(users))
+		for i, user := range users {
+			rows[i] = userRow{Name: user.Name, Email: user.Email}
+		}
+		return rows, nil
+	case "csv":
+		return parseUserRowsCSV(data)
+	default:
+		return nil, fmt.Errorf("userservice: unsupported import format %q", format)
+	}
+}
+
+func marshalUsersCSV(users []User) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"id", "name", "email"}); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if err := w.Write([]string{strconv.Itoa(user.ID), user.Name, user.Email}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func parseUserRowsCSV(data []byte) ([]userRow, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	nameCol, emailCol := -1, -1
+	for i, col := range records[0] {
+		switch col {
+		case "name":
+			nameCol = i
+		case "email":
+			emailCol = i
+		}
+	}
+	if nameCol == -1 || emailCol == -1 {
+		return nil, fmt.Errorf("userservice: csv header must include name and email columns")
+	}
+
+	rows := make([]userRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		rows = append(rows, userRow{Name: record[nameCol], Email: record[emailCol]})
+	}
+	return rows, nil
+}
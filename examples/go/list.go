@@ -0,0 +1,56 @@
+package userservice
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ListOptions controls pagination, search and sorting for ListUsers.
+type ListOptions struct {
+	// Offset is the number of matching users to skip.
+	Offset int
+	// Limit caps the number of users returned. Limit <= 0 means no limit.
+	Limit int
+	// Query filters users whose name or email contains Query, case-insensitive.
+	Query string
+	// SortBy is one of "id", "name" or "email". Defaults to "id".
+	SortBy string
+	// Desc reverses the sort order.
+	Desc bool
+}
+
+// UserPage is a page of users plus enough information to request the next one.
+type UserPage struct {
+	Items  []User `json:"items"`
+	Total  int    `json:"total"`
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+}
+
+// UserDetails is a User plus the bookkeeping fields that ListUsers and
+// GetAllUsers don't bother returning.
+type UserDetails struct {
+	User
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// MarshalJSON is defined explicitly because User.MarshalJSON would otherwise
+// be promoted onto UserDetails and silently drop CreatedAt/UpdatedAt.
+func (d UserDetails) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ID        int       `json:"id"`
+		Name      string    `json:"name"`
+		Email     string    `json:"email"`
+		Admin     bool      `json:"admin"`
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}{
+		ID:        d.ID,
+		Name:      d.Name,
+		Email:     d.Email,
+		Admin:     d.Admin,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	})
+}
@@ -0,0 +1,100 @@
+package userservice
+
+import (
+	"context"
+	"testing"
+)
+
+func seedUsers(t *testing.T, s *UserService) {
+	t.Helper()
+	ctx := context.Background()
+	for _, u := range []struct{ name, email string }{
+		{"Charlie", "charlie@example.com"},
+		{"Alice", "alice@example.com"},
+		{"Bob", "bob@example.com"},
+	} {
+		if _, err := s.CreateUser(ctx, u.name, u.email); err != nil {
+			t.Fatalf("seed CreateUser(%q): %v", u.name, err)
+		}
+	}
+}
+
+func TestListUsersSort(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	seedUsers(t, s)
+
+	page, err := s.ListUsers(context.Background(), ListOptions{SortBy: "name"})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	want := []string{"Alice", "Bob", "Charlie"}
+	if len(page.Items) != len(want) {
+		t.Fatalf("got %d items, want %d", len(page.Items), len(want))
+	}
+	for i, name := range want {
+		if page.Items[i].Name != name {
+			t.Fatalf("Items[%d].Name = %q, want %q", i, page.Items[i].Name, name)
+		}
+	}
+}
+
+func TestListUsersPagination(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	seedUsers(t, s)
+
+	page, err := s.ListUsers(context.Background(), ListOptions{SortBy: "name", Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	if page.Total != 3 {
+		t.Fatalf("Total = %d, want 3", page.Total)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Bob" {
+		t.Fatalf("Items = %+v, want [Bob]", page.Items)
+	}
+
+	// Offset past the end yields no items but the correct total.
+	page, err = s.ListUsers(context.Background(), ListOptions{Offset: 10, Limit: 5})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	if len(page.Items) != 0 || page.Total != 3 {
+		t.Fatalf("ListUsers with out-of-range offset = %+v", page)
+	}
+}
+
+func TestListUsersSearch(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	seedUsers(t, s)
+
+	page, err := s.SearchUsers(context.Background(), "ALICE")
+	if err != nil {
+		t.Fatalf("SearchUsers: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Alice" {
+		t.Fatalf("SearchUsers(ALICE) = %+v, want [Alice]", page.Items)
+	}
+}
+
+func TestGetAllUsersShimMatchesListUsers(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	seedUsers(t, s)
+
+	all, err := s.GetAllUsers(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllUsers: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("GetAllUsers returned %d users, want 3", len(all))
+	}
+
+	page, err := s.ListUsers(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	for i, user := range page.Items {
+		if all[i].ID != user.ID {
+			t.Fatalf("GetAllUsers()[%d].ID = %d, ListUsers Items[%d].ID = %d", i, all[i].ID, i, user.ID)
+		}
+	}
+}
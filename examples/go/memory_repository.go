@@ -0,0 +1,189 @@
+package userservice
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// InMemoryUserRepository is a UserRepository backed by a plain slice guarded
+// by a mutex. It is what the Wails demo boots with by default; swap it for a
+// SQLiteUserRepository (or your own implementation) to back the same
+// UserService with a real database.
+type InMemoryUserRepository struct {
+	mu        sync.RWMutex
+	users     []User
+	createdAt map[int]time.Time
+	updatedAt map[int]time.Time
+	nextID    int64
+}
+
+// NewInMemoryUserRepository creates an empty in-memory repository.
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{
+		createdAt: make(map[int]time.Time),
+		updatedAt: make(map[int]time.Time),
+	}
+}
+
+func (r *InMemoryUserRepository) GetAll(ctx context.Context) ([]User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	users := make([]User, len(r.users))
+	copy(users, r.users)
+	return users, nil
+}
+
+func (r *InMemoryUserRepository) GetByID(ctx context.Context, id int) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.userByIDLocked(id)
+}
+
+func (r *InMemoryUserRepository) userByIDLocked(id int) (User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return User{}, ErrUserNotFound
+}
+
+func (r *InMemoryUserRepository) GetDetails(ctx context.Context, id int) (UserDetails, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	user, err := r.userByIDLocked(id)
+	if err != nil {
+		return UserDetails{}, err
+	}
+	return UserDetails{
+		User:      user,
+		CreatedAt: r.createdAt[id],
+		UpdatedAt: r.updatedAt[id],
+	}, nil
+}
+
+func (r *InMemoryUserRepository) List(ctx context.Context, opts ListOptions) (UserPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]User, 0, len(r.users))
+	query := strings.ToLower(opts.Query)
+	for _, user := range r.users {
+		if query == "" ||
+			strings.Contains(strings.ToLower(user.Name), query) ||
+			strings.Contains(strings.ToLower(user.Email), query) {
+			matched = append(matched, user)
+		}
+	}
+
+	less := func(i, j int) bool {
+		switch opts.SortBy {
+		case "name":
+			return matched[i].Name < matched[j].Name
+		case "email":
+			return matched[i].Email < matched[j].Email
+		default:
+			return matched[i].ID < matched[j].ID
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if opts.Desc {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+
+	total := len(matched)
+	offset := opts.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if opts.Limit > 0 && offset+opts.Limit < end {
+		end = offset + opts.Limit
+	}
+
+	return UserPage{
+		Items:  matched[offset:end],
+		Total:  total,
+		Offset: opts.Offset,
+		Limit:  opts.Limit,
+	}, nil
+}
+
+// emailTakenLocked reports ErrDuplicateEmail if email belongs to a user
+// other than excludeID. Callers must hold r.mu.
+func (r *InMemoryUserRepository) emailTakenLocked(email string, excludeID int) error {
+	for _, user := range r.users {
+		if user.Email == email && user.ID != excludeID {
+			return ErrDuplicateEmail
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryUserRepository) Create(ctx context.Context, user User) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.emailTakenLocked(user.Email, 0); err != nil {
+		return User{}, err
+	}
+
+	user.ID = int(atomic.AddInt64(&r.nextID, 1))
+	r.users = append(r.users, user)
+	now := time.Now()
+	r.createdAt[user.ID] = now
+	r.updatedAt[user.ID] = now
+	return user, nil
+}
+
+func (r *InMemoryUserRepository) Update(ctx context.Context, user User) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.emailTakenLocked(user.Email, user.ID); err != nil {
+		return User{}, err
+	}
+
+	for i, existing := range r.users {
+		if existing.ID == user.ID {
+			r.users[i] = user
+			r.updatedAt[user.ID] = time.Now()
+			return user, nil
+		}
+	}
+	return User{}, ErrUserNotFound
+}
+
+func (r *InMemoryUserRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, user := range r.users {
+		if user.ID == id {
+			r.users = append(r.users[:i], r.users[i+1:]...)
+			delete(r.createdAt, id)
+			delete(r.updatedAt, id)
+			return nil
+		}
+	}
+	return ErrUserNotFound
+}
+
+func (r *InMemoryUserRepository) FindByEmail(ctx context.Context, email string) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return User{}, ErrUserNotFound
+}
@@ -0,0 +1,37 @@
+package userservice
+
+import "golang.org/x/crypto/bcrypt"
+
+// authConfig holds the knobs RegisterUser and ChangePassword enforce.
+type authConfig struct {
+	bcryptCost        int
+	minPasswordLength int
+	// dummyHash is compared against on an unknown-email Authenticate call so
+	// that path costs the same as a wrong-password one. See Authenticate.
+	dummyHash []byte
+}
+
+func defaultAuthConfig() authConfig {
+	return authConfig{
+		bcryptCost:        bcrypt.DefaultCost,
+		minPasswordLength: 8,
+	}
+}
+
+// Option configures a UserService at construction time.
+type Option func(*authConfig)
+
+// WithBcryptCost overrides the bcrypt cost used to hash passwords.
+func WithBcryptCost(cost int) Option {
+	return func(c *authConfig) {
+		c.bcryptCost = cost
+	}
+}
+
+// WithMinPasswordLength overrides the minimum password length accepted by
+// RegisterUser and ChangePassword.
+func WithMinPasswordLength(n int) Option {
+	return func(c *authConfig) {
+		c.minPasswordLength = n
+	}
+}
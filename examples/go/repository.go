@@ -0,0 +1,17 @@
+package userservice
+
+import "context"
+
+// UserRepository is the persistence boundary UserService depends on. Swap
+// implementations (in-memory, SQLite, ...) to change how users are stored
+// without touching the service or its Wails bindings.
+type UserRepository interface {
+	GetAll(ctx context.Context) ([]User, error)
+	GetByID(ctx context.Context, id int) (User, error)
+	GetDetails(ctx context.Context, id int) (UserDetails, error)
+	List(ctx context.Context, opts ListOptions) (UserPage, error)
+	Create(ctx context.Context, user User) (User, error)
+	Update(ctx context.Context, user User) (User, error)
+	Delete(ctx context.Context, id int) error
+	FindByEmail(ctx context.Context, email string) (User, error)
+}
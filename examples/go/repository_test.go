@@ -0,0 +1,61 @@
+package userservice
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInMemoryUserRepositoryCRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryUserRepository()
+
+	if _, err := repo.GetByID(ctx, 1); err != ErrUserNotFound {
+		t.Fatalf("GetByID on empty repo = %v, want ErrUserNotFound", err)
+	}
+
+	created, err := repo.Create(ctx, User{Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("Create did not assign an ID")
+	}
+
+	got, err := repo.GetByID(ctx, created.ID)
+	if err != nil || got.Name != "Ada" || got.Email != "ada@example.com" {
+		t.Fatalf("GetByID(%d) = %+v, %v", created.ID, got, err)
+	}
+
+	found, err := repo.FindByEmail(ctx, "ada@example.com")
+	if err != nil || found.ID != created.ID {
+		t.Fatalf("FindByEmail = %+v, %v", found, err)
+	}
+
+	created.Name = "Ada Lovelace"
+	updated, err := repo.Update(ctx, created)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.Name != "Ada Lovelace" {
+		t.Fatalf("Update did not persist name change: %+v", updated)
+	}
+
+	if err := repo.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, created.ID); err != ErrUserNotFound {
+		t.Fatalf("GetByID after Delete = %v, want ErrUserNotFound", err)
+	}
+	if err := repo.Delete(ctx, created.ID); err != ErrUserNotFound {
+		t.Fatalf("Delete on missing user = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestInMemoryUserRepositoryUpdateNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryUserRepository()
+
+	if _, err := repo.Update(ctx, User{ID: 99, Name: "Ghost", Email: "ghost@example.com"}); err != ErrUserNotFound {
+		t.Fatalf("Update on missing user = %v, want ErrUserNotFound", err)
+	}
+}
@@ -0,0 +1,254 @@
+package userservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// migrations is applied in order on startup so callers don't need a
+// separate migration step to try the demo against a real database. ALTER
+// TABLE statements are tolerant of already having run, since SQLite has no
+// "ADD COLUMN IF NOT EXISTS".
+var migrations = []string{
+	`CREATE TABLE IF NOT EXISTS users (
+		id    INTEGER PRIMARY KEY AUTOINCREMENT,
+		name  TEXT NOT NULL,
+		email TEXT NOT NULL UNIQUE
+	)`,
+	`ALTER TABLE users ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP`,
+	`ALTER TABLE users ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP`,
+	`ALTER TABLE users ADD COLUMN password_hash BLOB`,
+	`ALTER TABLE users ADD COLUMN admin BOOLEAN NOT NULL DEFAULT 0`,
+}
+
+// isUniqueConstraintErr reports whether err came from the users.email UNIQUE
+// constraint, so callers can surface the repository-agnostic ErrDuplicateEmail
+// instead of a raw driver error.
+func isUniqueConstraintErr(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
+
+func migrate(db *sql.DB) error {
+	for _, stmt := range migrations {
+		if _, err := db.Exec(stmt); err != nil {
+			if strings.Contains(err.Error(), "duplicate column name") {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// SQLiteUserRepository is a UserRepository backed by database/sql and
+// SQLite.
+type SQLiteUserRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteUserRepository opens (or creates) the SQLite database at path and
+// runs its schema migrations before returning.
+//
+// SQLite only allows one writer at a time; a second *sql.DB connection
+// writing concurrently fails with SQLITE_BUSY rather than queuing, which
+// defeats the "parallel Wails frontend calls" guarantee the in-memory
+// repository gives via its mutex. db.SetMaxOpenConns(1) serializes access
+// through database/sql's own connection pool instead, and busy_timeout makes
+// any contention that slips through (e.g. another process holding the file)
+// wait rather than fail immediately.
+func NewSQLiteUserRepository(path string) (*SQLiteUserRepository, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("userservice: open sqlite: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(`PRAGMA busy_timeout = 5000`); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("userservice: set busy_timeout: %w", err)
+	}
+	if _, err := db.Exec(`PRAGMA journal_mode = WAL`); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("userservice: enable WAL: %w", err)
+	}
+
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("userservice: migrate schema: %w", err)
+	}
+	return &SQLiteUserRepository{db: db}, nil
+}
+
+func (r *SQLiteUserRepository) GetAll(ctx context.Context) ([]User, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name, email, admin, password_hash FROM users ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var user User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Admin, &user.passwordHash); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *SQLiteUserRepository) GetByID(ctx context.Context, id int) (User, error) {
+	var user User
+	row := r.db.QueryRowContext(ctx, `SELECT id, name, email, admin, password_hash FROM users WHERE id = ?`, id)
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Admin, &user.passwordHash); err != nil {
+		if err == sql.ErrNoRows {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+	return user, nil
+}
+
+func (r *SQLiteUserRepository) GetDetails(ctx context.Context, id int) (UserDetails, error) {
+	var details UserDetails
+	row := r.db.QueryRowContext(ctx, `SELECT id, name, email, admin, password_hash, created_at, updated_at FROM users WHERE id = ?`, id)
+	if err := row.Scan(&details.ID, &details.Name, &details.Email, &details.Admin, &details.passwordHash, &details.CreatedAt, &details.UpdatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return UserDetails{}, ErrUserNotFound
+		}
+		return UserDetails{}, err
+	}
+	return details, nil
+}
+
+func (r *SQLiteUserRepository) List(ctx context.Context, opts ListOptions) (UserPage, error) {
+	var (
+		where string
+		args  []any
+	)
+	if opts.Query != "" {
+		where = `WHERE LOWER(name) LIKE ? OR LOWER(email) LIKE ?`
+		like := "%" + strings.ToLower(opts.Query) + "%"
+		args = append(args, like, like)
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM users ` + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return UserPage{}, err
+	}
+
+	orderBy := "id"
+	switch opts.SortBy {
+	case "name", "email":
+		orderBy = opts.SortBy
+	}
+	direction := "ASC"
+	if opts.Desc {
+		direction = "DESC"
+	}
+
+	query := fmt.Sprintf(`SELECT id, name, email, admin, password_hash FROM users %s ORDER BY %s %s`, where, orderBy, direction)
+	queryArgs := append([]any{}, args...)
+	if opts.Limit > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		queryArgs = append(queryArgs, opts.Limit, opts.Offset)
+	} else if opts.Offset > 0 {
+		query += ` LIMIT -1 OFFSET ?`
+		queryArgs = append(queryArgs, opts.Offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, queryArgs...)
+	if err != nil {
+		return UserPage{}, err
+	}
+	defer rows.Close()
+
+	items := make([]User, 0)
+	for rows.Next() {
+		var user User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Admin, &user.passwordHash); err != nil {
+			return UserPage{}, err
+		}
+		items = append(items, user)
+	}
+	if err := rows.Err(); err != nil {
+		return UserPage{}, err
+	}
+
+	return UserPage{Items: items, Total: total, Offset: opts.Offset, Limit: opts.Limit}, nil
+}
+
+func (r *SQLiteUserRepository) Create(ctx context.Context, user User) (User, error) {
+	res, err := r.db.ExecContext(ctx, `INSERT INTO users (name, email, admin, password_hash, created_at, updated_at) VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`,
+		user.Name, user.Email, user.Admin, user.passwordHash)
+	if err != nil {
+		if isUniqueConstraintErr(err) {
+			return User{}, ErrDuplicateEmail
+		}
+		return User{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return User{}, err
+	}
+	user.ID = int(id)
+	return user, nil
+}
+
+func (r *SQLiteUserRepository) Update(ctx context.Context, user User) (User, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET name = ?, email = ?, admin = ?, password_hash = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`,
+		user.Name, user.Email, user.Admin, user.passwordHash, user.ID)
+	if err != nil {
+		if isUniqueConstraintErr(err) {
+			return User{}, ErrDuplicateEmail
+		}
+		return User{}, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return User{}, err
+	}
+	if n == 0 {
+		return User{}, ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (r *SQLiteUserRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *SQLiteUserRepository) FindByEmail(ctx context.Context, email string) (User, error) {
+	var user User
+	row := r.db.QueryRowContext(ctx, `SELECT id, name, email, admin, password_hash FROM users WHERE email = ?`, email)
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Admin, &user.passwordHash); err != nil {
+		if err == sql.ErrNoRows {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+	return user, nil
+}
+
+// Close releases the underlying database handle.
+func (r *SQLiteUserRepository) Close() error {
+	return r.db.Close()
+}
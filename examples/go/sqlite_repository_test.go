@@ -0,0 +1,141 @@
+package userservice
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func newTestSQLiteRepo(t *testing.T) *SQLiteUserRepository {
+	t.Helper()
+	repo, err := NewSQLiteUserRepository(filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteUserRepository: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+func TestSQLiteUserRepositoryCRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSQLiteRepo(t)
+
+	if _, err := repo.GetByID(ctx, 1); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("GetByID on empty repo = %v, want ErrUserNotFound", err)
+	}
+
+	created, err := repo.Create(ctx, User{Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("Create did not assign an ID")
+	}
+
+	got, err := repo.GetByID(ctx, created.ID)
+	if err != nil || got.Name != "Ada" || got.Email != "ada@example.com" {
+		t.Fatalf("GetByID(%d) = %+v, %v", created.ID, got, err)
+	}
+
+	found, err := repo.FindByEmail(ctx, "ada@example.com")
+	if err != nil || found.ID != created.ID {
+		t.Fatalf("FindByEmail = %+v, %v", found, err)
+	}
+
+	created.Name = "Ada Lovelace"
+	updated, err := repo.Update(ctx, created)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.Name != "Ada Lovelace" {
+		t.Fatalf("Update did not persist name change: %+v", updated)
+	}
+
+	if err := repo.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, created.ID); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("GetByID after Delete = %v, want ErrUserNotFound", err)
+	}
+	if err := repo.Delete(ctx, created.ID); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("Delete on missing user = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestSQLiteUserRepositoryDuplicateEmail(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSQLiteRepo(t)
+
+	if _, err := repo.Create(ctx, User{Name: "Ada", Email: "ada@example.com"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := repo.Create(ctx, User{Name: "Ada Two", Email: "ada@example.com"}); !errors.Is(err, ErrDuplicateEmail) {
+		t.Fatalf("Create with duplicate email = %v, want ErrDuplicateEmail", err)
+	}
+
+	bob, err := repo.Create(ctx, User{Name: "Bob", Email: "bob@example.com"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	bob.Email = "ada@example.com"
+	if _, err := repo.Update(ctx, bob); !errors.Is(err, ErrDuplicateEmail) {
+		t.Fatalf("Update to duplicate email = %v, want ErrDuplicateEmail", err)
+	}
+}
+
+func TestSQLiteUserRepositoryGetDetails(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSQLiteRepo(t)
+
+	created, err := repo.Create(ctx, User{Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	details, err := repo.GetDetails(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetDetails: %v", err)
+	}
+	if details.CreatedAt.IsZero() || details.UpdatedAt.IsZero() {
+		t.Fatalf("GetDetails timestamps not set: %+v", details)
+	}
+
+	if _, err := repo.GetDetails(ctx, created.ID+1); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("GetDetails on missing user = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestSQLiteUserRepositoryList(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSQLiteRepo(t)
+
+	for _, u := range []struct{ name, email string }{
+		{"Charlie", "charlie@example.com"},
+		{"Alice", "alice@example.com"},
+		{"Bob", "bob@example.com"},
+	} {
+		if _, err := repo.Create(ctx, User{Name: u.name, Email: u.email}); err != nil {
+			t.Fatalf("Create(%q): %v", u.name, err)
+		}
+	}
+
+	page, err := repo.List(ctx, ListOptions{SortBy: "name", Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if page.Total != 3 {
+		t.Fatalf("Total = %d, want 3", page.Total)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Bob" {
+		t.Fatalf("Items = %+v, want [Bob]", page.Items)
+	}
+
+	page, err = repo.List(ctx, ListOptions{Query: "ALICE"})
+	if err != nil {
+		t.Fatalf("List with query: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Alice" {
+		t.Fatalf("List(Query=ALICE) = %+v, want [Alice]", page.Items)
+	}
+}
@@ -1,63 +1,167 @@
 package userservice
 
-// User represents a user in the system
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// User represents a user in the system. passwordHash is intentionally
+// unexported so it can never leak into a generated Wails binding; MarshalJSON
+// makes that explicit rather than relying on the field being unexported.
 type User struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	Admin        bool   `json:"admin"`
+	passwordHash []byte
+}
+
+// MarshalJSON never emits passwordHash, even if the field were ever exported.
+func (u User) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		Admin bool   `json:"admin"`
+	}{
+		ID:    u.ID,
+		Name:  u.Name,
+		Email: u.Email,
+		Admin: u.Admin,
+	})
 }
 
-// UserService handles user-related operations
+// UserService handles user-related operations. It delegates persistence to a
+// UserRepository so the Wails demo can run against an in-memory store or a
+// real database without changing any of the bound methods below.
 type UserService struct {
-	users []User
+	repo   UserRepository
+	auth   authConfig
+	events *broker
 }
 
-// GetAllUsers returns all users
-// This is the function that GetUsers() in JS bindings will call
-func (s *UserService) GetAllUsers() []User {
-	return s.users
+// NewUserService creates a UserService backed by the given repository.
+func NewUserService(repo UserRepository, opts ...Option) *UserService {
+	s := &UserService{repo: repo, auth: defaultAuthConfig(), events: newBroker()}
+	for _, opt := range opts {
+		opt(&s.auth)
+	}
+	// Precompute a dummy hash at the configured cost so Authenticate can
+	// compare against it on an unknown email, keeping that path's timing
+	// indistinguishable from a wrong-password one.
+	s.auth.dummyHash, _ = bcrypt.GenerateFromPassword([]byte("userservice-dummy-password"), s.auth.bcryptCost)
+	return s
 }
 
-// GetUserByID returns a specific user by ID
-func (s *UserService) GetUserByID(id int) *User {
-	for _, user := range s.users {
-		if user.ID == id {
-			return &user
-		}
+// GetAllUsers returns all users.
+//
+// Deprecated: use ListUsers, which supports pagination, search and sort.
+// This only keeps the method name around for callers migrating off the old
+// "fetch everything" API; its signature already changed from
+// GetAllUsers() []User to this ctx/error-returning form, so it is not a
+// binding-compatible shim.
+func (s *UserService) GetAllUsers(ctx context.Context) ([]User, error) {
+	page, err := s.ListUsers(ctx, ListOptions{})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return page.Items, nil
+}
+
+// ListUsers returns a page of users matching opts.
+func (s *UserService) ListUsers(ctx context.Context, opts ListOptions) (UserPage, error) {
+	return s.repo.List(ctx, opts)
+}
+
+// SearchUsers is a thin convenience wrapper over ListUsers for a plain
+// name/email substring search.
+func (s *UserService) SearchUsers(ctx context.Context, query string) (UserPage, error) {
+	return s.ListUsers(ctx, ListOptions{Query: query})
+}
+
+// GetUserByID returns a specific user by ID
+func (s *UserService) GetUserByID(ctx context.Context, id int) (User, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// GetUserDetails returns a user along with its CreatedAt/UpdatedAt timestamps.
+func (s *UserService) GetUserDetails(ctx context.Context, id int) (UserDetails, error) {
+	return s.repo.GetDetails(ctx, id)
 }
 
-// CreateUser creates a new user
-func (s *UserService) CreateUser(name, email string) User {
-	user := User{
-		ID:    len(s.users) + 1,
-		Name:  name,
-		Email: email,
+// CreateUser creates a new user. It returns ErrEmptyName, ErrInvalidEmail or
+// ErrDuplicateEmail if name/email are invalid or the email is already taken.
+func (s *UserService) CreateUser(ctx context.Context, name, email string) (User, error) {
+	if err := validateName(name); err != nil {
+		return User{}, err
+	}
+	if err := validateEmail(email); err != nil {
+		return User{}, err
+	}
+	if err := s.checkEmailAvailable(ctx, email, 0); err != nil {
+		return User{}, err
+	}
+	user, err := s.repo.Create(ctx, User{Name: name, Email: email})
+	if err != nil {
+		return User{}, err
 	}
-	s.users = append(s.users, user)
-	return user
+	s.events.emit(UserEvent{Kind: EventCreated, User: user, At: time.Now()})
+	return user, nil
 }
 
-// UpdateUser updates an existing user
-func (s *UserService) UpdateUser(id int, name, email string) bool {
-	for i, user := range s.users {
-		if user.ID == id {
-			s.users[i].Name = name
-			s.users[i].Email = email
-			return true
+// UserUpdate describes a partial update to a user. Nil fields are left
+// untouched, which lets Wails bindings distinguish "don't touch" from
+// "set empty".
+type UserUpdate struct {
+	Name  *string
+	Email *string
+}
+
+// UpdateUser applies a partial update to the user with the given ID. It
+// returns ErrUserNotFound, ErrEmptyName, ErrInvalidEmail or
+// ErrDuplicateEmail as appropriate.
+func (s *UserService) UpdateUser(ctx context.Context, id int, update UserUpdate) (User, error) {
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+
+	if update.Name != nil {
+		if err := validateName(*update.Name); err != nil {
+			return User{}, err
 		}
+		user.Name = *update.Name
 	}
-	return false
+	if update.Email != nil {
+		if err := validateEmail(*update.Email); err != nil {
+			return User{}, err
+		}
+		if err := s.checkEmailAvailable(ctx, *update.Email, id); err != nil {
+			return User{}, err
+		}
+		user.Email = *update.Email
+	}
+
+	updated, err := s.repo.Update(ctx, user)
+	if err != nil {
+		return User{}, err
+	}
+	s.events.emit(UserEvent{Kind: EventUpdated, User: updated, At: time.Now()})
+	return updated, nil
 }
 
 // DeleteUser deletes a user by ID
-func (s *UserService) DeleteUser(id int) bool {
-	for i, user := range s.users {
-		if user.ID == id {
-			s.users = append(s.users[:i], s.users[i+1:]...)
-			return true
-		}
+func (s *UserService) DeleteUser(ctx context.Context, id int) error {
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
 	}
-	return false
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.events.emit(UserEvent{Kind: EventDeleted, User: user, At: time.Now()})
+	return nil
 }
@@ -0,0 +1,39 @@
+package userservice
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+)
+
+// validateName reports ErrEmptyName for blank names.
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrEmptyName
+	}
+	return nil
+}
+
+// validateEmail reports ErrInvalidEmail for anything net/mail can't parse.
+func validateEmail(email string) error {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return ErrInvalidEmail
+	}
+	return nil
+}
+
+// checkEmailAvailable reports ErrDuplicateEmail if email is already taken by
+// a user other than excludeID.
+func (s *UserService) checkEmailAvailable(ctx context.Context, email string, excludeID int) error {
+	existing, err := s.repo.FindByEmail(ctx, email)
+	if err == ErrUserNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.ID != excludeID {
+		return ErrDuplicateEmail
+	}
+	return nil
+}
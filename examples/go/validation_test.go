@@ -0,0 +1,74 @@
+package userservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCreateUserValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		wantErr error
+	}{
+		{name: "", email: "a@example.com", wantErr: ErrEmptyName},
+		{name: "   ", email: "a@example.com", wantErr: ErrEmptyName},
+		{name: "Ada", email: "not-an-email", wantErr: ErrInvalidEmail},
+		{name: "Ada", email: "ada@example.com", wantErr: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.email, func(t *testing.T) {
+			s := NewUserService(NewInMemoryUserRepository())
+			_, err := s.CreateUser(context.Background(), tt.name, tt.email)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateUser(%q, %q) error = %v, want %v", tt.name, tt.email, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateUserDuplicateEmail(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	if _, err := s.CreateUser(ctx, "Ada", "ada@example.com"); err != nil {
+		t.Fatalf("first CreateUser: %v", err)
+	}
+	if _, err := s.CreateUser(ctx, "Ada Two", "ada@example.com"); !errors.Is(err, ErrDuplicateEmail) {
+		t.Fatalf("second CreateUser error = %v, want ErrDuplicateEmail", err)
+	}
+}
+
+func TestUpdateUserDuplicateEmail(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	ctx := context.Background()
+
+	if _, err := s.CreateUser(ctx, "Ada", "ada@example.com"); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	bob, err := s.CreateUser(ctx, "Bob", "bob@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	taken := "ada@example.com"
+	if _, err := s.UpdateUser(ctx, bob.ID, UserUpdate{Email: &taken}); !errors.Is(err, ErrDuplicateEmail) {
+		t.Fatalf("UpdateUser to taken email error = %v, want ErrDuplicateEmail", err)
+	}
+
+	// Updating a user's own email to its current value must not be treated
+	// as a conflict with itself.
+	if _, err := s.UpdateUser(ctx, bob.ID, UserUpdate{Email: &bob.Email}); err != nil {
+		t.Fatalf("UpdateUser to own email: %v", err)
+	}
+}
+
+func TestUpdateUserNotFound(t *testing.T) {
+	s := NewUserService(NewInMemoryUserRepository())
+	name := "Ghost"
+	if _, err := s.UpdateUser(context.Background(), 404, UserUpdate{Name: &name}); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("UpdateUser on missing user error = %v, want ErrUserNotFound", err)
+	}
+}